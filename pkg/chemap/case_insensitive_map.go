@@ -0,0 +1,84 @@
+package chemap
+
+import "strings"
+
+// Structs
+
+// CaseInsensitiveMap A map with string keys where keys that differ only in their casing ("Content-Type" and
+// "content-type") are considered the same key. The casing of the first key used to store a value is preserved.
+type CaseInsensitiveMap[V any] struct {
+	keys   map[string]string
+	values map[string]V
+}
+
+// Put Stores "value" under "key". If an equivalent key already exists, its value is replaced but its original casing
+// is kept.
+func (m *CaseInsensitiveMap[V]) Put(key string, value V) {
+	canonicalKey := strings.ToLower(key)
+
+	if _, found := m.keys[canonicalKey]; !found {
+		m.keys[canonicalKey] = key
+	}
+
+	m.values[canonicalKey] = value
+}
+
+// Get Returns the value stored under "key", ignoring its casing, and true if it was found. Returns the zero value and
+// false otherwise.
+func (m *CaseInsensitiveMap[V]) Get(key string) (V, bool) {
+	value, found := m.values[strings.ToLower(key)]
+
+	return value, found
+}
+
+// Has Returns true if a value is stored under "key", ignoring its casing. Returns false otherwise.
+func (m *CaseInsensitiveMap[V]) Has(key string) bool {
+	_, found := m.values[strings.ToLower(key)]
+
+	return found
+}
+
+// Remove Removes the value stored under "key", ignoring its casing.
+func (m *CaseInsensitiveMap[V]) Remove(key string) {
+	canonicalKey := strings.ToLower(key)
+
+	delete(m.keys, canonicalKey)
+	delete(m.values, canonicalKey)
+}
+
+// Len Returns the amount of keys stored in the map.
+func (m *CaseInsensitiveMap[V]) Len() int {
+	return len(m.values)
+}
+
+// Keys Returns a slice with the keys of the map, using their original casing.
+func (m *CaseInsensitiveMap[V]) Keys() []string {
+	result := make([]string, 0, len(m.keys))
+
+	for _, key := range m.keys {
+		result = append(result, key)
+	}
+
+	return result
+}
+
+// ToMap Returns a regular map with the contents of this map, using the original casing of the keys.
+func (m *CaseInsensitiveMap[V]) ToMap() map[string]V {
+	result := make(map[string]V, len(m.values))
+
+	for canonicalKey, value := range m.values {
+		result[m.keys[canonicalKey]] = value
+	}
+
+	return result
+}
+
+// Functions
+
+// NewCaseInsensitiveMap Returns a new, empty, CaseInsensitiveMap.
+func NewCaseInsensitiveMap[V any]() *CaseInsensitiveMap[V] {
+	return &CaseInsensitiveMap[V]{
+		keys:   make(map[string]string),
+		values: make(map[string]V),
+	}
+}
@@ -0,0 +1,99 @@
+package chemap_test
+
+import (
+	"fmt"
+	"github.com/comfortablynumb/che/pkg/chemap"
+	"github.com/comfortablynumb/che/pkg/chetest"
+	"sort"
+	"testing"
+)
+
+func TestCaseInsensitiveMap(t *testing.T) {
+	type entry struct {
+		key   string
+		value int
+	}
+
+	cases := []struct {
+		put          []entry
+		remove       []string
+		lookupKey    string
+		expectedHas  bool
+		expectedGet  int
+		expectedKeys []string
+		expectedMap  map[string]int
+	}{
+		{
+			[]entry{{"Content-Type", 1}, {"content-type", 2}, {"Accept", 3}},
+			[]string{},
+			"CONTENT-TYPE",
+			true,
+			2,
+			[]string{"Accept", "Content-Type"},
+			map[string]int{"Content-Type": 2, "Accept": 3},
+		},
+		{
+			[]entry{{"Content-Type", 1}, {"Accept", 3}},
+			[]string{"ACCEPT"},
+			"accept",
+			false,
+			0,
+			[]string{"Content-Type"},
+			map[string]int{"Content-Type": 1},
+		},
+		{
+			[]entry{{"Content-Type", 1}, {"Accept", 3}},
+			[]string{"content-type"},
+			"Content-Type",
+			false,
+			0,
+			[]string{"Accept"},
+			map[string]int{"Accept": 3},
+		},
+		{
+			[]entry{},
+			[]string{"unknown"},
+			"unknown",
+			false,
+			0,
+			[]string{},
+			map[string]int{},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestCaseInsensitiveMap_Case-%d", i), func(t *testing.T) {
+			m := chemap.NewCaseInsensitiveMap[int]()
+
+			for _, e := range c.put {
+				m.Put(e.key, e.value)
+			}
+
+			for _, key := range c.remove {
+				m.Remove(key)
+			}
+
+			value, found := m.Get(c.lookupKey)
+			keys := m.Keys()
+
+			sort.Strings(keys)
+
+			chetest.RequireEqual(t, found, c.expectedHas)
+			chetest.RequireEqual(t, m.Has(c.lookupKey), c.expectedHas)
+			chetest.RequireEqual(t, value, c.expectedGet)
+			chetest.RequireEqual(t, m.Len(), len(c.expectedMap))
+			chetest.RequireEqual(t, keys, c.expectedKeys)
+			chetest.RequireEqual(t, m.ToMap(), c.expectedMap)
+		})
+	}
+}
+
+func TestCaseInsensitiveMap_CasingAfterRemove(t *testing.T) {
+	m := chemap.NewCaseInsensitiveMap[string]()
+
+	m.Put("X-Request-Id", "first")
+	m.Remove("x-request-id")
+	m.Put("X-REQUEST-ID", "second")
+
+	chetest.RequireEqual(t, m.ToMap(), map[string]string{"X-REQUEST-ID": "second"})
+}
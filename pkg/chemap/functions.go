@@ -1,5 +1,13 @@
 package chemap
 
+// Structs
+
+// Entry A key / value pair from a map.
+type Entry[K comparable, T any] struct {
+	Key   K
+	Value T
+}
+
 // Functions
 
 // Keys Returns a slice with the keys found on the given map.
@@ -12,3 +20,36 @@ func Keys[K comparable, T any](m map[K]T) []K {
 
 	return result
 }
+
+// Values Returns a slice with the values found on the given map.
+func Values[K comparable, T any](m map[K]T) []T {
+	result := make([]T, 0, len(m))
+
+	for _, v := range m {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// Entries Returns a slice with the key / value pairs found on the given map.
+func Entries[K comparable, T any](m map[K]T) []Entry[K, T] {
+	result := make([]Entry[K, T], 0, len(m))
+
+	for k, v := range m {
+		result = append(result, Entry[K, T]{Key: k, Value: v})
+	}
+
+	return result
+}
+
+// FromEntries Returns a new map with the given key / value pairs. If a key is repeated, the LAST value wins.
+func FromEntries[K comparable, T any](entries []Entry[K, T]) map[K]T {
+	result := make(map[K]T, len(entries))
+
+	for _, entry := range entries {
+		result[entry.Key] = entry.Value
+	}
+
+	return result
+}
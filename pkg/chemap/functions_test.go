@@ -48,3 +48,110 @@ func TestKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestValues(t *testing.T) {
+	cases := []struct {
+		theMap         map[string]int
+		expectedValues []int
+	}{
+		{
+			map[string]int{
+				"a": 3,
+				"b": 1,
+				"c": 2,
+				"d": 1,
+			},
+			[]int{1, 1, 2, 3},
+		},
+		{
+			map[string]int{},
+			[]int{},
+		},
+		{
+			nil,
+			[]int{},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestValues_Case-%d", i), func(t *testing.T) {
+			result := chemap.Values(c.theMap)
+
+			sort.Ints(result)
+
+			chetest.RequireEqual(t, result, c.expectedValues)
+		})
+	}
+}
+
+func TestEntries(t *testing.T) {
+	cases := []struct {
+		theMap          map[string]int
+		expectedEntries []chemap.Entry[string, int]
+	}{
+		{
+			map[string]int{
+				"b": 1,
+				"a": 2,
+			},
+			[]chemap.Entry[string, int]{
+				{Key: "a", Value: 2},
+				{Key: "b", Value: 1},
+			},
+		},
+		{
+			nil,
+			[]chemap.Entry[string, int]{},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestEntries_Case-%d", i), func(t *testing.T) {
+			result := chemap.Entries(c.theMap)
+
+			sort.Slice(result, func(i, j int) bool {
+				return result[i].Key < result[j].Key
+			})
+
+			chetest.RequireEqual(t, result, c.expectedEntries)
+
+			// Converting the entries back must give the original map
+
+			if c.theMap != nil {
+				chetest.RequireEqual(t, chemap.FromEntries(result), c.theMap)
+			}
+		})
+	}
+}
+
+func TestFromEntries(t *testing.T) {
+	cases := []struct {
+		entries     []chemap.Entry[string, int]
+		expectedMap map[string]int
+	}{
+		{
+			[]chemap.Entry[string, int]{
+				{Key: "a", Value: 1},
+				{Key: "b", Value: 2},
+				{Key: "a", Value: 3},
+			},
+			map[string]int{"a": 3, "b": 2},
+		},
+		{
+			[]chemap.Entry[string, int]{},
+			map[string]int{},
+		},
+		{
+			nil,
+			map[string]int{},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestFromEntries_Case-%d", i), func(t *testing.T) {
+			result := chemap.FromEntries(c.entries)
+
+			chetest.RequireEqual(t, result, c.expectedMap)
+		})
+	}
+}
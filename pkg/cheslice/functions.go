@@ -1,13 +1,77 @@
 package cheslice
 
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Interfaces
+
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
 // Types
 
 type ForEachFunc[T any] func(element T) bool
 
+type TapFunc[T any] func(element T)
+
 type MapFunc[T any] func(element T) T
 
 type FilterFunc[T any] func(element T) bool
 
+type KeyFunc[T any, K comparable] func(element T) K
+
+type TransformFunc[T any, R any] func(element T) R
+
+type TryTransformFunc[T any, R any] func(element T) (R, error)
+
+type AccumulatorFunc[T any, R any] func(accumulator R, element T) R
+
+type EntryFunc[T any, K comparable, V any] func(element T) (K, V)
+
+type ZipFunc[A any, B any, R any] func(a A, b B) R
+
+type CompareFunc[T any, K any] func(element T, target K) int
+
+type MovingAverageOption func(options *movingAverageOptions)
+
+// Seq Has the same underlying type as Go's iter.Seq, so callers using Go 1.23+ can range over it directly. Older
+// versions can call it with a "yield" function which returns false to stop the iteration.
+type Seq[T any] func(yield func(element T) bool)
+
+// Structs
+
+// Pair Two values that belong together, like the ones produced by "Zip".
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Run A value and the amount of times it appears consecutively, as produced by "RunLengthEncode".
+type Run[T any] struct {
+	Value T
+	Count uint
+}
+
+type movingAverageOptions struct {
+	partialWindows bool
+}
+
 // Functions
 
 // Union Returns a new slice with all the elements found in the given slices. It preserves repeated elements.
@@ -215,3 +279,668 @@ func Len[T any](slices ...[]T) int {
 
 	return result
 }
+
+// Dedup Returns a new slice where consecutive repeated elements are collapsed into a single one. Unlike "Unique",
+// repeated elements that are not adjacent are preserved, so it's best suited for sorted slices.
+func Dedup[T comparable](slice []T) []T {
+	result := make([]T, 0)
+
+	for i, element := range slice {
+		if i > 0 && element == slice[i-1] {
+			continue
+		}
+
+		result = append(result, element)
+	}
+
+	return result
+}
+
+// DedupBy Works like "Dedup", but two adjacent elements are considered repeated when "keyFunc" returns the same key for
+// both of them. The first element of each run is the one that is kept.
+func DedupBy[T any, K comparable](slice []T, keyFunc KeyFunc[T, K]) []T {
+	result := make([]T, 0)
+
+	var lastKey K
+
+	for i, element := range slice {
+		key := keyFunc(element)
+
+		if i > 0 && key == lastKey {
+			continue
+		}
+
+		result = append(result, element)
+
+		lastKey = key
+	}
+
+	return result
+}
+
+// ParallelMap Returns a new slice with the result of applying "transformFunc" to each of the elements from the given
+// slice, splitting the work among up to "workers" goroutines. The order of the result matches the order of the given
+// slice. If "workers" is lower than 2 or the slice has less than 2 elements, the work is done sequentially.
+func ParallelMap[T any, R any](slice []T, workers uint, transformFunc TransformFunc[T, R]) []R {
+	sliceSize := uint(len(slice))
+	result := make([]R, sliceSize)
+
+	if workers > sliceSize {
+		workers = sliceSize
+	}
+
+	if workers < 2 {
+		for i, element := range slice {
+			result[i] = transformFunc(element)
+		}
+
+		return result
+	}
+
+	chunkSize := (sliceSize + workers - 1) / workers
+	wg := sync.WaitGroup{}
+
+	for start := uint(0); start < sliceSize; start += chunkSize {
+		end := start + chunkSize
+
+		if end > sliceSize {
+			end = sliceSize
+		}
+
+		wg.Add(1)
+
+		go func(start uint, end uint) {
+			defer wg.Done()
+
+			for i := start; i < end; i++ {
+				result[i] = transformFunc(slice[i])
+			}
+		}(start, end)
+	}
+
+	wg.Wait()
+
+	return result
+}
+
+// SlidingMax Returns a new slice with the maximum value of each window of "window" consecutive elements, in order. The
+// result has one element per window position (len(slice) - window + 1). If "window" is 0 or greater than the length of
+// the slice, it returns an empty slice. It runs in O(n) using a monotonic deque.
+func SlidingMax[T Ordered](slice []T, window uint) []T {
+	return slidingExtreme(slice, window, func(a T, b T) bool {
+		return a >= b
+	})
+}
+
+// SlidingMin Works like "SlidingMax", but returns the minimum value of each window.
+func SlidingMin[T Ordered](slice []T, window uint) []T {
+	return slidingExtreme(slice, window, func(a T, b T) bool {
+		return a <= b
+	})
+}
+
+func slidingExtreme[T Ordered](slice []T, window uint, dominates func(a T, b T) bool) []T {
+	sliceSize := uint(len(slice))
+
+	if window < 1 || window > sliceSize {
+		return make([]T, 0)
+	}
+
+	result := make([]T, 0, sliceSize-window+1)
+
+	// Indexes of candidate elements. Their values are kept in dominance order, so the front is always the extreme of
+	// the current window.
+
+	deque := make([]uint, 0, window)
+
+	for i := uint(0); i < sliceSize; i++ {
+		if len(deque) > 0 && deque[0]+window <= i {
+			deque = deque[1:]
+		}
+
+		for len(deque) > 0 && dominates(slice[i], slice[deque[len(deque)-1]]) {
+			deque = deque[:len(deque)-1]
+		}
+
+		deque = append(deque, i)
+
+		if i+1 >= window {
+			result = append(result, slice[deque[0]])
+		}
+	}
+
+	return result
+}
+
+// Scan Returns a new slice with the running results of applying "accumulatorFunc" to each element of the given slice,
+// starting from "initial". The result has the same length as the slice: it contains the accumulator obtained after
+// each element, but NOT the initial value itself.
+func Scan[T any, R any](slice []T, initial R, accumulatorFunc AccumulatorFunc[T, R]) []R {
+	result := make([]R, 0, len(slice))
+	accumulator := initial
+
+	for _, element := range slice {
+		accumulator = accumulatorFunc(accumulator, element)
+
+		result = append(result, accumulator)
+	}
+
+	return result
+}
+
+// Compact Returns a new slice without the elements that are equal to the zero value of their type (empty strings, 0,
+// nil pointers, etc.). The order of the remaining elements is preserved.
+func Compact[T comparable](slice []T) []T {
+	var zero T
+
+	return CompactFunc(slice, func(element T) bool {
+		return element == zero
+	})
+}
+
+// CompactFunc Returns a new slice without the elements for which "isEmptyFunc" returned true. It's useful for types
+// that are not comparable. The order of the remaining elements is preserved.
+func CompactFunc[T any](slice []T, isEmptyFunc FilterFunc[T]) []T {
+	return Filter(slice, func(element T) bool {
+		return !isEmptyFunc(element)
+	})
+}
+
+// InsertAt Returns a new slice with "values" inserted at position "index" of the given slice. If "index" is negative,
+// values are inserted at the beginning. If it's greater than the length of the slice, they are appended at the end.
+func InsertAt[T any](slice []T, index int, values ...T) []T {
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(slice) {
+		index = len(slice)
+	}
+
+	result := make([]T, 0, len(slice)+len(values))
+
+	result = append(result, slice[:index]...)
+	result = append(result, values...)
+	result = append(result, slice[index:]...)
+
+	return result
+}
+
+// RemoveAt Returns a new slice without the element found at position "index" of the given slice. If "index" is out of
+// bounds, it returns an unmodified copy of the slice.
+func RemoveAt[T any](slice []T, index int) []T {
+	return RemoveRange(slice, index, index+1)
+}
+
+// RemoveRange Returns a new slice without the elements found between "start" (inclusive) and "end" (exclusive). Both
+// positions are clamped to the bounds of the slice. If the resulting range is empty, it returns an unmodified copy of
+// the slice.
+func RemoveRange[T any](slice []T, start int, end int) []T {
+	if start < 0 {
+		start = 0
+	}
+
+	if end > len(slice) {
+		end = len(slice)
+	}
+
+	result := make([]T, 0, len(slice))
+
+	if start >= end {
+		return append(result, slice...)
+	}
+
+	result = append(result, slice[:start]...)
+	result = append(result, slice[end:]...)
+
+	return result
+}
+
+// Tap Executes the given "tapFunc" on each of the elements of the received slice and returns the same slice, unchanged.
+// Unlike "ForEach", it can be placed between other calls (like "Map" or "Filter") to inspect intermediate values.
+func Tap[T any](slice []T, tapFunc TapFunc[T]) []T {
+	for _, element := range slice {
+		tapFunc(element)
+	}
+
+	return slice
+}
+
+// ChunkSeq Works like "Chunk", but yields the chunks lazily instead of building them all up front. Each yielded chunk
+// is a sub-slice of the given slice, so it shares its backing array (though appending to a chunk never overwrites the
+// elements that follow it). Use "ChunkCopySeq" to get independent chunks.
+func ChunkSeq[T any](slice []T, length uint) Seq[[]T] {
+	return func(yield func(chunk []T) bool) {
+		if length < 1 {
+			return
+		}
+
+		sliceSize := uint(len(slice))
+
+		for start := uint(0); start < sliceSize; start += length {
+			end := start + length
+
+			if end > sliceSize {
+				end = sliceSize
+			}
+
+			if !yield(slice[start:end:end]) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkCopySeq Works like "ChunkSeq", but each yielded chunk is a new slice which does not share memory with the given
+// slice.
+func ChunkCopySeq[T any](slice []T, length uint) Seq[[]T] {
+	return func(yield func(chunk []T) bool) {
+		ChunkSeq(slice, length)(func(chunk []T) bool {
+			chunkCopy := make([]T, 0, len(chunk))
+
+			return yield(append(chunkCopy, chunk...))
+		})
+	}
+}
+
+// KeyBy Returns a map with the elements of the given slice indexed by the key returned by "keyFunc". If more than one
+// element has the same key, the LAST one wins. Use "KeyByFirst" to keep the first one instead.
+func KeyBy[T any, K comparable](slice []T, keyFunc KeyFunc[T, K]) map[K]T {
+	result := make(map[K]T, len(slice))
+
+	for _, element := range slice {
+		result[keyFunc(element)] = element
+	}
+
+	return result
+}
+
+// KeyByFirst Works like "KeyBy", but if more than one element has the same key, the FIRST one wins.
+func KeyByFirst[T any, K comparable](slice []T, keyFunc KeyFunc[T, K]) map[K]T {
+	result := make(map[K]T, len(slice))
+
+	for _, element := range slice {
+		key := keyFunc(element)
+
+		if _, found := result[key]; found {
+			continue
+		}
+
+		result[key] = element
+	}
+
+	return result
+}
+
+// ToMap Returns a map with the key / value pairs returned by "entryFunc" for each of the elements of the given slice.
+// If more than one element produces the same key, the LAST one wins.
+func ToMap[T any, K comparable, V any](slice []T, entryFunc EntryFunc[T, K, V]) map[K]V {
+	result := make(map[K]V, len(slice))
+
+	for _, element := range slice {
+		key, value := entryFunc(element)
+
+		result[key] = value
+	}
+
+	return result
+}
+
+// Product Returns the cartesian product of the given slices: every combination built by taking one element from each
+// slice, in order. If no slice is given, it returns a single empty combination. If any slice is empty, it returns an
+// empty slice. Keep in mind the result has len(slice1) * len(slice2) * ... elements, so it grows really fast. Use
+// "ProductSeq" to avoid building all the combinations at once.
+func Product[T any](slices ...[]T) [][]T {
+	result := make([][]T, 0)
+
+	ProductSeq(slices...)(func(combination []T) bool {
+		result = append(result, combination)
+
+		return true
+	})
+
+	return result
+}
+
+// ProductSeq Works like "Product", but yields the combinations lazily. Each yielded combination is a new slice.
+func ProductSeq[T any](slices ...[]T) Seq[[]T] {
+	return func(yield func(combination []T) bool) {
+		for _, slice := range slices {
+			if len(slice) == 0 {
+				return
+			}
+		}
+
+		indexes := make([]int, len(slices))
+
+		for {
+			combination := make([]T, 0, len(slices))
+
+			for i, slice := range slices {
+				combination = append(combination, slice[indexes[i]])
+			}
+
+			if !yield(combination) {
+				return
+			}
+
+			// Advance the indexes like an odometer, starting from the last slice
+
+			position := len(slices) - 1
+
+			for ; position >= 0; position-- {
+				indexes[position]++
+
+				if indexes[position] < len(slices[position]) {
+					break
+				}
+
+				indexes[position] = 0
+			}
+
+			if position < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Sample Returns a new slice with "count" elements chosen randomly from the given slice, without replacement (an
+// element at a given position is never picked twice). If "count" is greater than the length of the slice, it's clamped
+// to it. The randomness comes from "source", which allows deterministic results in tests. If it's nil, a source seeded
+// with the current time is used.
+func Sample[T any](slice []T, count uint, source rand.Source) []T {
+	sliceSize := uint(len(slice))
+
+	if count > sliceSize {
+		count = sliceSize
+	}
+
+	random := newRand(source)
+	indexes := make([]int, sliceSize)
+	result := make([]T, 0, count)
+
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	// Partial Fisher-Yates shuffle: only the first "count" positions are needed
+
+	for i := uint(0); i < count; i++ {
+		j := i + uint(random.Int63n(int64(sliceSize-i)))
+
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+
+		result = append(result, slice[indexes[i]])
+	}
+
+	return result
+}
+
+// Pick Returns a random element from the given slice and true. If the slice is empty, it returns the zero value and
+// false. The "source" argument works the same way as in "Sample".
+func Pick[T any](slice []T, source rand.Source) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return slice[newRand(source).Intn(len(slice))], true
+}
+
+func newRand(source rand.Source) *rand.Rand {
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	return rand.New(source)
+}
+
+// Zip Returns a new slice with pairs made of the elements found at the same position in both slices. The length of the
+// result is the length of the shortest slice.
+func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWith(a, b, func(first A, second B) Pair[A, B] {
+		return Pair[A, B]{First: first, Second: second}
+	})
+}
+
+// ZipWith Returns a new slice with the result of applying "zipFunc" to the elements found at the same position in both
+// slices. The length of the result is the length of the shortest slice.
+func ZipWith[A any, B any, R any](a []A, b []B, zipFunc ZipFunc[A, B, R]) []R {
+	length := len(a)
+
+	if len(b) < length {
+		length = len(b)
+	}
+
+	result := make([]R, 0, length)
+
+	for i := 0; i < length; i++ {
+		result = append(result, zipFunc(a[i], b[i]))
+	}
+
+	return result
+}
+
+// Unzip Does the opposite of "Zip": returns two new slices, one with the first element of each pair and another one
+// with the second element of each pair.
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	first := make([]A, 0, len(pairs))
+	second := make([]B, 0, len(pairs))
+
+	for _, pair := range pairs {
+		first = append(first, pair.First)
+		second = append(second, pair.Second)
+	}
+
+	return first, second
+}
+
+// TryMap Returns a new slice with the result of applying "transformFunc" to each of the elements from the given slice.
+// It stops at the first error, returning a nil slice and that error wrapped with the index of the failing element.
+func TryMap[T any, R any](slice []T, transformFunc TryTransformFunc[T, R]) ([]R, error) {
+	result := make([]R, 0, len(slice))
+
+	for i, element := range slice {
+		transformed, err := transformFunc(element)
+
+		if err != nil {
+			return nil, fmt.Errorf("element at index %d: %w", i, err)
+		}
+
+		result = append(result, transformed)
+	}
+
+	return result, nil
+}
+
+// TryMapAll Works like "TryMap", but it does NOT stop at the first error. It applies "transformFunc" to all the
+// elements and, if any of them failed, returns a nil slice and all the errors joined with "errors.Join".
+func TryMapAll[T any, R any](slice []T, transformFunc TryTransformFunc[T, R]) ([]R, error) {
+	result := make([]R, 0, len(slice))
+	errs := make([]error, 0)
+
+	for i, element := range slice {
+		transformed, err := transformFunc(element)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("element at index %d: %w", i, err))
+
+			continue
+		}
+
+		result = append(result, transformed)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return result, nil
+}
+
+// BinarySearch Searches "target" in the given slice, which MUST be sorted in ascending order. Returns the position of
+// the first element equal to "target" and true if it was found. Otherwise, returns the position where it would have
+// to be inserted to keep the slice sorted, and false.
+func BinarySearch[T Ordered](sorted []T, target T) (int, bool) {
+	return BinarySearchBy(sorted, target, func(element T, target T) int {
+		switch {
+		case element < target:
+			return -1
+		case element > target:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// BinarySearchBy Works like "BinarySearch", but elements are compared using "compareFunc", which must return a negative
+// number if the element goes before "target", a positive one if it goes after it, and 0 if they are equal. The slice
+// MUST be sorted in the same order defined by "compareFunc".
+func BinarySearchBy[T any, K any](sorted []T, target K, compareFunc CompareFunc[T, K]) (int, bool) {
+	index := sort.Search(len(sorted), func(i int) bool {
+		return compareFunc(sorted[i], target) >= 0
+	})
+
+	return index, index < len(sorted) && compareFunc(sorted[index], target) == 0
+}
+
+// Changes Compares two versions of a slice and returns the elements found in "newSlice" that are NOT present in
+// "oldSlice" (added), and the ones found in "oldSlice" that are NOT present in "newSlice" (removed). Repeated elements
+// are returned once, in the order they first appear. It's the same as calling "Diff" twice with swapped arguments, but
+// using maps instead of nested loops.
+func Changes[T comparable](oldSlice []T, newSlice []T) ([]T, []T) {
+	oldElements := toSet(oldSlice)
+	newElements := toSet(newSlice)
+
+	return missingFrom(newSlice, oldElements), missingFrom(oldSlice, newElements)
+}
+
+func toSet[T comparable](slice []T) map[T]struct{} {
+	result := make(map[T]struct{}, len(slice))
+
+	for _, element := range slice {
+		result[element] = struct{}{}
+	}
+
+	return result
+}
+
+func missingFrom[T comparable](slice []T, set map[T]struct{}) []T {
+	result := make([]T, 0)
+	checkedElements := make(map[T]struct{})
+
+	for _, element := range slice {
+		if _, found := checkedElements[element]; found {
+			continue
+		}
+
+		checkedElements[element] = struct{}{}
+
+		if _, found := set[element]; !found {
+			result = append(result, element)
+		}
+	}
+
+	return result
+}
+
+// GroupConsecutive Returns a new slice with the runs of adjacent equal elements found in the given slice, in order.
+// Unlike grouping by value, equal elements that are not adjacent end up in different runs.
+func GroupConsecutive[T comparable](slice []T) [][]T {
+	result := make([][]T, 0)
+
+	for i, element := range slice {
+		if i > 0 && element == slice[i-1] {
+			result[len(result)-1] = append(result[len(result)-1], element)
+
+			continue
+		}
+
+		result = append(result, []T{element})
+	}
+
+	return result
+}
+
+// RunLengthEncode Returns a new slice with one "Run" for each run of adjacent equal elements found in the given slice,
+// in order.
+func RunLengthEncode[T comparable](slice []T) []Run[T] {
+	result := make([]Run[T], 0)
+
+	for i, element := range slice {
+		if i > 0 && element == slice[i-1] {
+			result[len(result)-1].Count++
+
+			continue
+		}
+
+		result = append(result, Run[T]{Value: element, Count: 1})
+	}
+
+	return result
+}
+
+// MovingAverage Returns a new slice with the average of each window of "window" consecutive elements, in order. By
+// default only full windows are used, so the result has len(slice) - window + 1 elements (or none, if "window" is
+// greater than the length of the slice). Use the "WithPartialWindows" option to also get the averages of the shorter
+// windows at the start, so the result has one element per element of the slice. If "window" is 0, it returns an
+// empty slice. Each window is summed independently, so it runs in O(len(slice) * window).
+func MovingAverage[T Number](slice []T, window uint, options ...MovingAverageOption) []float64 {
+	opts := &movingAverageOptions{
+		partialWindows: false,
+	}
+
+	for _, option := range options {
+		option(opts)
+	}
+
+	result := make([]float64, 0, len(slice))
+
+	if window < 1 {
+		return result
+	}
+
+	// Each window is summed from scratch. Keeping a running sum (adding the new element and subtracting the one that
+	// leaves the window) is cheaper, but rounding errors, overflows and infinities would leak into later windows.
+
+	for end := uint(1); end <= uint(len(slice)); end++ {
+		start := uint(0)
+
+		if end > window {
+			start = end - window
+		}
+
+		if end-start < window && !opts.partialWindows {
+			continue
+		}
+
+		sum := float64(0)
+
+		for _, element := range slice[start:end] {
+			sum += float64(element)
+		}
+
+		result = append(result, sum/float64(end-start))
+	}
+
+	return result
+}
+
+// WithPartialWindows Makes "MovingAverage" include the averages of the windows at the start of the slice which have
+// less than "window" elements.
+func WithPartialWindows() MovingAverageOption {
+	return func(options *movingAverageOptions) {
+		options.partialWindows = true
+	}
+}
+
+// CumulativeSum Returns a new slice where each element is the sum of all the elements of the given slice up to (and
+// including) the one at the same position.
+func CumulativeSum[T Number](slice []T) []T {
+	return Scan(slice, T(0), func(accumulator T, element T) T {
+		return accumulator + element
+	})
+}
@@ -1,8 +1,13 @@
 package cheslice_test
 
 import (
+	"errors"
 	"fmt"
 	"github.com/comfortablynumb/che/pkg/chetest"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/comfortablynumb/che/pkg/cheslice"
@@ -325,3 +330,863 @@ func TestLen(t *testing.T) {
 		})
 	}
 }
+
+func TestDedup(t *testing.T) {
+	cases := []struct {
+		input    []any
+		expected []any
+	}{
+		{[]any{1, 1, 2, 2, 2, 3}, []any{1, 2, 3}},
+		{[]any{1, 2, 1, 1, 2, 2, 1}, []any{1, 2, 1, 2, 1}},
+		{[]any{1}, []any{1}},
+		{[]any{}, []any{}},
+		{nil, []any{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestDedup_Case-%d", i), func(t *testing.T) {
+			result := cheslice.Dedup(c.input)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestDedupBy(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected []string
+	}{
+		{[]string{"a", "A", "b", "B", "b", "a"}, []string{"a", "b", "a"}},
+		{[]string{"B", "b"}, []string{"B"}},
+		{[]string{}, []string{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestDedupBy_Case-%d", i), func(t *testing.T) {
+			result := cheslice.DedupBy(c.input, strings.ToLower)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	cases := []struct {
+		input    []int
+		workers  uint
+		expected []string
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, []string{"1", "2", "3", "4", "5", "6", "7"}},
+		{[]int{1, 2, 3}, 10, []string{"1", "2", "3"}},
+		{[]int{1, 2, 3}, 1, []string{"1", "2", "3"}},
+		{[]int{1, 2, 3}, 0, []string{"1", "2", "3"}},
+		{[]int{}, 4, []string{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestParallelMap_Case-%d", i), func(t *testing.T) {
+			result := cheslice.ParallelMap(c.input, c.workers, strconv.Itoa)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestSlidingMax(t *testing.T) {
+	cases := []struct {
+		input    []int
+		window   uint
+		expected []int
+	}{
+		{[]int{1, 3, -1, -3, 5, 3, 6, 7}, 3, []int{3, 3, 5, 5, 6, 7}},
+		{[]int{9, 8, 7, 6}, 2, []int{9, 8, 7}},
+		{[]int{4, 2, 12, 3}, 1, []int{4, 2, 12, 3}},
+		{[]int{4, 2, 12, 3}, 4, []int{12}},
+		{[]int{4, 2, 12, 3}, 5, []int{}},
+		{[]int{4, 2, 12, 3}, 0, []int{}},
+		{[]int{}, 2, []int{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestSlidingMax_Case-%d", i), func(t *testing.T) {
+			result := cheslice.SlidingMax(c.input, c.window)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestSlidingMin(t *testing.T) {
+	cases := []struct {
+		input    []float64
+		window   uint
+		expected []float64
+	}{
+		{[]float64{1, 3, -1, -3, 5, 3, 6, 7}, 3, []float64{-1, -3, -3, -3, 3, 3}},
+		{[]float64{1, 2, 3, 4}, 2, []float64{1, 2, 3}},
+		{[]float64{2, 2, 2}, 2, []float64{2, 2}},
+		{[]float64{1, 2}, 3, []float64{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestSlidingMin_Case-%d", i), func(t *testing.T) {
+			result := cheslice.SlidingMin(c.input, c.window)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		input    []int
+		initial  string
+		expected []string
+	}{
+		{[]int{1, 2, 3}, "", []string{"1", "12", "123"}},
+		{[]int{1, 2, 3}, "0", []string{"01", "012", "0123"}},
+		{[]int{}, "0", []string{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestScan_Case-%d", i), func(t *testing.T) {
+			result := cheslice.Scan(c.input, c.initial, func(accumulator string, element int) string {
+				return accumulator + strconv.Itoa(element)
+			})
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected []string
+	}{
+		{[]string{"", "a", "", "b", ""}, []string{"a", "b"}},
+		{[]string{"", ""}, []string{}},
+		{[]string{}, []string{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestCompact_Case-%d", i), func(t *testing.T) {
+			result := cheslice.Compact(c.input)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestCompact_Pointers(t *testing.T) {
+	someValue := 0
+
+	cases := []struct {
+		input    []*int
+		expected []*int
+	}{
+		{[]*int{nil, &someValue, nil}, []*int{&someValue}},
+		{[]*int{nil}, []*int{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestCompact_Pointers_Case-%d", i), func(t *testing.T) {
+			result := cheslice.Compact(c.input)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	cases := []struct {
+		input    [][]int
+		expected [][]int
+	}{
+		{[][]int{{1}, nil, {}, {2, 3}}, [][]int{{1}, {2, 3}}},
+		{[][]int{nil, {}}, [][]int{}},
+		{[][]int{}, [][]int{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestCompactFunc_Case-%d", i), func(t *testing.T) {
+			result := cheslice.CompactFunc(c.input, func(element []int) bool {
+				return len(element) == 0
+			})
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestInsertAt(t *testing.T) {
+	cases := []struct {
+		input    []any
+		index    int
+		values   []any
+		expected []any
+	}{
+		{[]any{1, 2, 3}, 1, []any{4, 5}, []any{1, 4, 5, 2, 3}},
+		{[]any{1, 2, 3}, 0, []any{4}, []any{4, 1, 2, 3}},
+		{[]any{1, 2, 3}, 3, []any{4}, []any{1, 2, 3, 4}},
+		{[]any{1, 2, 3}, -5, []any{4}, []any{4, 1, 2, 3}},
+		{[]any{1, 2, 3}, 10, []any{4}, []any{1, 2, 3, 4}},
+		{[]any{1, 2, 3}, 1, []any{}, []any{1, 2, 3}},
+		{[]any{}, 0, []any{1}, []any{1}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestInsertAt_Case-%d", i), func(t *testing.T) {
+			inputCopy := make([]any, 0, len(c.input))
+			inputCopy = append(inputCopy, c.input...)
+
+			result := cheslice.InsertAt(c.input, c.index, c.values...)
+
+			chetest.RequireEqual(t, result, c.expected)
+
+			// Confirm the original slice was not modified
+
+			chetest.RequireEqual(t, c.input, inputCopy)
+		})
+	}
+}
+
+func TestRemoveAt(t *testing.T) {
+	cases := []struct {
+		input    []any
+		index    int
+		expected []any
+	}{
+		{[]any{1, 2, 3}, 1, []any{1, 3}},
+		{[]any{1, 2, 3}, 0, []any{2, 3}},
+		{[]any{1, 2, 3}, 2, []any{1, 2}},
+		{[]any{1, 2, 3}, 3, []any{1, 2, 3}},
+		{[]any{1, 2, 3}, -1, []any{1, 2, 3}},
+		{[]any{}, 0, []any{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestRemoveAt_Case-%d", i), func(t *testing.T) {
+			inputCopy := make([]any, 0, len(c.input))
+			inputCopy = append(inputCopy, c.input...)
+
+			result := cheslice.RemoveAt(c.input, c.index)
+
+			chetest.RequireEqual(t, result, c.expected)
+
+			// Confirm the original slice was not modified
+
+			chetest.RequireEqual(t, c.input, inputCopy)
+		})
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	cases := []struct {
+		input    []any
+		start    int
+		end      int
+		expected []any
+	}{
+		{[]any{1, 2, 3, 4, 5}, 1, 3, []any{1, 4, 5}},
+		{[]any{1, 2, 3, 4, 5}, -2, 2, []any{3, 4, 5}},
+		{[]any{1, 2, 3, 4, 5}, 3, 10, []any{1, 2, 3}},
+		{[]any{1, 2, 3, 4, 5}, 0, 5, []any{}},
+		{[]any{1, 2, 3, 4, 5}, 3, 3, []any{1, 2, 3, 4, 5}},
+		{[]any{1, 2, 3, 4, 5}, 4, 1, []any{1, 2, 3, 4, 5}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestRemoveRange_Case-%d", i), func(t *testing.T) {
+			inputCopy := make([]any, 0, len(c.input))
+			inputCopy = append(inputCopy, c.input...)
+
+			result := cheslice.RemoveRange(c.input, c.start, c.end)
+
+			chetest.RequireEqual(t, result, c.expected)
+
+			// Confirm the original slice was not modified
+
+			chetest.RequireEqual(t, c.input, inputCopy)
+		})
+	}
+}
+
+func TestTap(t *testing.T) {
+	cases := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{}, []int{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestTap_Case-%d", i), func(t *testing.T) {
+			tapped := make([]int, 0)
+
+			result := cheslice.Tap(c.input, func(element int) {
+				tapped = append(tapped, element)
+			})
+
+			chetest.RequireEqual(t, result, c.input)
+			chetest.RequireEqual(t, tapped, c.expected)
+		})
+	}
+}
+
+func TestChunkSeq(t *testing.T) {
+	cases := []struct {
+		input     []any
+		length    uint
+		maxChunks int
+		expected  [][]any
+	}{
+		{[]any{1, 2, 3}, 5, 10, [][]any{{1, 2, 3}}},
+		{[]any{1, 2, 3, 4, 3, 2, 1}, 2, 10, [][]any{{1, 2}, {3, 4}, {3, 2}, {1}}},
+		{[]any{1, 2, 3, 4, 3, 2, 1}, 2, 2, [][]any{{1, 2}, {3, 4}}},
+		{[]any{1, 2, 3, 4, 3, 2, 1}, 0, 10, [][]any{}},
+		{[]any{}, 2, 10, [][]any{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestChunkSeq_Case-%d", i), func(t *testing.T) {
+			for _, seq := range []cheslice.Seq[[]any]{
+				cheslice.ChunkSeq(c.input, c.length),
+				cheslice.ChunkCopySeq(c.input, c.length),
+			} {
+				result := make([][]any, 0)
+
+				seq(func(chunk []any) bool {
+					result = append(result, chunk)
+
+					return len(result) < c.maxChunks
+				})
+
+				chetest.RequireEqual(t, result, c.expected)
+			}
+		})
+	}
+}
+
+func TestChunkSeq_Memory(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	grownChunks := make([][]int, 0)
+
+	cheslice.ChunkSeq(input, 2)(func(chunk []int) bool {
+		chunk[0] = 0
+
+		// Appending must not overwrite the next chunk
+
+		grownChunks = append(grownChunks, append(chunk, 100))
+
+		return true
+	})
+
+	chetest.RequireEqual(t, input, []int{0, 2, 0, 4})
+	chetest.RequireEqual(t, grownChunks, [][]int{{0, 2, 100}, {0, 4, 100}})
+
+	cheslice.ChunkCopySeq(input, 2)(func(chunk []int) bool {
+		chunk[0] = 100
+
+		return true
+	})
+
+	chetest.RequireEqual(t, input, []int{0, 2, 0, 4})
+}
+
+func TestKeyBy(t *testing.T) {
+	cases := []struct {
+		input         []string
+		expected      map[int]string
+		expectedFirst map[int]string
+	}{
+		{
+			[]string{"a", "bb", "ccc"},
+			map[int]string{1: "a", 2: "bb", 3: "ccc"},
+			map[int]string{1: "a", 2: "bb", 3: "ccc"},
+		},
+		{
+			[]string{"a", "bb", "c", "dd"},
+			map[int]string{1: "c", 2: "dd"},
+			map[int]string{1: "a", 2: "bb"},
+		},
+		{
+			[]string{},
+			map[int]string{},
+			map[int]string{},
+		},
+	}
+
+	keyFunc := func(element string) int {
+		return len(element)
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestKeyBy_Case-%d", i), func(t *testing.T) {
+			chetest.RequireEqual(t, cheslice.KeyBy(c.input, keyFunc), c.expected)
+			chetest.RequireEqual(t, cheslice.KeyByFirst(c.input, keyFunc), c.expectedFirst)
+		})
+	}
+}
+
+func TestToMap(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected map[string]int
+	}{
+		{[]string{"a=1", "b=2"}, map[string]int{"a": 1, "b": 2}},
+		{[]string{"a=1", "b=2", "a=3"}, map[string]int{"a": 3, "b": 2}},
+		{[]string{}, map[string]int{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestToMap_Case-%d", i), func(t *testing.T) {
+			result := cheslice.ToMap(c.input, func(element string) (string, int) {
+				parts := strings.Split(element, "=")
+				value, _ := strconv.Atoi(parts[1])
+
+				return parts[0], value
+			})
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestProduct(t *testing.T) {
+	cases := []struct {
+		input    [][]any
+		expected [][]any
+	}{
+		{
+			[][]any{{1, 2}, {"a", "b", "c"}},
+			[][]any{{1, "a"}, {1, "b"}, {1, "c"}, {2, "a"}, {2, "b"}, {2, "c"}},
+		},
+		{
+			[][]any{{1, 2}, {3}, {4, 5}},
+			[][]any{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}},
+		},
+		{[][]any{{1, 2}}, [][]any{{1}, {2}}},
+		{[][]any{{1, 2}, {}}, [][]any{}},
+		{[][]any{}, [][]any{{}}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestProduct_Case-%d", i), func(t *testing.T) {
+			result := cheslice.Product(c.input...)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestProductSeq(t *testing.T) {
+	result := make([][]int, 0)
+
+	cheslice.ProductSeq([]int{1, 2}, []int{3, 4})(func(combination []int) bool {
+		result = append(result, combination)
+
+		return len(result) < 3
+	})
+
+	chetest.RequireEqual(t, result, [][]int{{1, 3}, {1, 4}, {2, 3}})
+}
+
+func TestSample(t *testing.T) {
+	cases := []struct {
+		input          []int
+		count          uint
+		source         rand.Source
+		expectedLength int
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 4, rand.NewSource(1), 4},
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 10, rand.NewSource(2), 10},
+		{[]int{1, 2, 3}, 20, rand.NewSource(3), 3},
+		{[]int{1, 2, 3}, 2, nil, 2},
+		{[]int{1, 2, 3}, 0, nil, 0},
+		{[]int{}, 2, nil, 0},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestSample_Case-%d", i), func(t *testing.T) {
+			inputCopy := make([]int, 0, len(c.input))
+			inputCopy = append(inputCopy, c.input...)
+
+			result := cheslice.Sample(c.input, c.count, c.source)
+
+			chetest.RequireEqual(t, len(result), c.expectedLength)
+			chetest.RequireEqual(t, cheslice.Unique(result), result)
+			chetest.RequireEqual(t, cheslice.Diff(result, c.input), []int{})
+
+			// Confirm the original slice was not modified
+
+			chetest.RequireEqual(t, c.input, inputCopy)
+		})
+	}
+}
+
+func TestSample_Deterministic(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	chetest.RequireEqual(
+		t,
+		cheslice.Sample(input, 5, rand.NewSource(42)),
+		cheslice.Sample(input, 5, rand.NewSource(42)),
+	)
+}
+
+func TestPick(t *testing.T) {
+	cases := []struct {
+		input         []string
+		source        rand.Source
+		expectedFound bool
+	}{
+		{[]string{"a", "b", "c"}, rand.NewSource(1), true},
+		{[]string{"a"}, nil, true},
+		{[]string{}, nil, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestPick_Case-%d", i), func(t *testing.T) {
+			result, found := cheslice.Pick(c.input, c.source)
+
+			chetest.RequireEqual(t, found, c.expectedFound)
+
+			if found {
+				chetest.RequireEqual(t, cheslice.Exists(result, c.input), true)
+			} else {
+				chetest.RequireEqual(t, result, "")
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	cases := []struct {
+		a        []int
+		b        []string
+		expected []cheslice.Pair[int, string]
+	}{
+		{[]int{1, 2}, []string{"a", "b"}, []cheslice.Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{[]int{1, 2, 3}, []string{"a"}, []cheslice.Pair[int, string]{{1, "a"}}},
+		{[]int{1}, []string{"a", "b"}, []cheslice.Pair[int, string]{{1, "a"}}},
+		{[]int{}, []string{"a"}, []cheslice.Pair[int, string]{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestZip_Case-%d", i), func(t *testing.T) {
+			result := cheslice.Zip(c.a, c.b)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	cases := []struct {
+		a        []string
+		b        []int
+		expected []string
+	}{
+		{[]string{"a", "b", "c"}, []int{1, 2, 3}, []string{"a", "bb", "ccc"}},
+		{[]string{"a", "b", "c"}, []int{2}, []string{"aa"}},
+		{nil, []int{2}, []string{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestZipWith_Case-%d", i), func(t *testing.T) {
+			result := cheslice.ZipWith(c.a, c.b, strings.Repeat)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	cases := []struct {
+		input          []cheslice.Pair[int, string]
+		expectedFirst  []int
+		expectedSecond []string
+	}{
+		{[]cheslice.Pair[int, string]{{1, "a"}, {2, "b"}}, []int{1, 2}, []string{"a", "b"}},
+		{[]cheslice.Pair[int, string]{}, []int{}, []string{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestUnzip_Case-%d", i), func(t *testing.T) {
+			first, second := cheslice.Unzip(c.input)
+
+			chetest.RequireEqual(t, first, c.expectedFirst)
+			chetest.RequireEqual(t, second, c.expectedSecond)
+		})
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	cases := []struct {
+		input         []string
+		expected      []int
+		expectedError string
+	}{
+		{[]string{"1", "2", "3"}, []int{1, 2, 3}, ""},
+		{[]string{"1", "a", "b"}, nil, `element at index 1: strconv.Atoi: parsing "a": invalid syntax`},
+		{[]string{}, []int{}, ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestTryMap_Case-%d", i), func(t *testing.T) {
+			result, err := cheslice.TryMap(c.input, strconv.Atoi)
+
+			chetest.RequireEqual(t, result, c.expected)
+			chetest.RequireEqual(t, errorString(err), c.expectedError)
+		})
+	}
+}
+
+func TestTryMap_WrapsError(t *testing.T) {
+	_, err := cheslice.TryMap([]string{"a"}, strconv.Atoi)
+
+	chetest.RequireEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+}
+
+func TestTryMapAll(t *testing.T) {
+	cases := []struct {
+		input         []string
+		expected      []int
+		expectedError string
+	}{
+		{[]string{"1", "2", "3"}, []int{1, 2, 3}, ""},
+		{
+			[]string{"1", "a", "b"},
+			nil,
+			"element at index 1: strconv.Atoi: parsing \"a\": invalid syntax\n" +
+				"element at index 2: strconv.Atoi: parsing \"b\": invalid syntax",
+		},
+		{[]string{}, []int{}, ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestTryMapAll_Case-%d", i), func(t *testing.T) {
+			result, err := cheslice.TryMapAll(c.input, strconv.Atoi)
+
+			chetest.RequireEqual(t, result, c.expected)
+			chetest.RequireEqual(t, errorString(err), c.expectedError)
+		})
+	}
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+func TestBinarySearch(t *testing.T) {
+	cases := []struct {
+		input         []int
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}{
+		{[]int{1, 3, 5, 7}, 5, 2, true},
+		{[]int{1, 3, 5, 7}, 1, 0, true},
+		{[]int{1, 3, 5, 7}, 7, 3, true},
+		{[]int{1, 3, 3, 3, 7}, 3, 1, true},
+		{[]int{1, 3, 5, 7}, 4, 2, false},
+		{[]int{1, 3, 5, 7}, 0, 0, false},
+		{[]int{1, 3, 5, 7}, 8, 4, false},
+		{[]int{}, 8, 0, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestBinarySearch_Case-%d", i), func(t *testing.T) {
+			index, found := cheslice.BinarySearch(c.input, c.target)
+
+			chetest.RequireEqual(t, index, c.expectedIndex)
+			chetest.RequireEqual(t, found, c.expectedFound)
+		})
+	}
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	type user struct {
+		id int
+	}
+
+	users := []user{{1}, {4}, {9}}
+
+	cases := []struct {
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}{
+		{4, 1, true},
+		{9, 2, true},
+		{5, 2, false},
+		{10, 3, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestBinarySearchBy_Case-%d", i), func(t *testing.T) {
+			index, found := cheslice.BinarySearchBy(users, c.target, func(element user, target int) int {
+				return element.id - target
+			})
+
+			chetest.RequireEqual(t, index, c.expectedIndex)
+			chetest.RequireEqual(t, found, c.expectedFound)
+		})
+	}
+}
+
+func TestChanges(t *testing.T) {
+	cases := []struct {
+		oldSlice        []string
+		newSlice        []string
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		{[]string{"a", "b", "c"}, []string{"b", "c", "d", "e"}, []string{"d", "e"}, []string{"a"}},
+		{[]string{"a", "a", "b"}, []string{"c", "c", "b"}, []string{"c"}, []string{"a"}},
+		{[]string{"a", "b"}, []string{"b", "a"}, []string{}, []string{}},
+		{[]string{}, []string{"a"}, []string{"a"}, []string{}},
+		{[]string{"a"}, nil, []string{}, []string{"a"}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestChanges_Case-%d", i), func(t *testing.T) {
+			added, removed := cheslice.Changes(c.oldSlice, c.newSlice)
+
+			chetest.RequireEqual(t, added, c.expectedAdded)
+			chetest.RequireEqual(t, removed, c.expectedRemoved)
+		})
+	}
+}
+
+func TestGroupConsecutive(t *testing.T) {
+	cases := []struct {
+		input    []any
+		expected [][]any
+	}{
+		{[]any{1, 1, 2, 3, 3, 3, 1}, [][]any{{1, 1}, {2}, {3, 3, 3}, {1}}},
+		{[]any{1, 2, 3}, [][]any{{1}, {2}, {3}}},
+		{[]any{1}, [][]any{{1}}},
+		{[]any{}, [][]any{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestGroupConsecutive_Case-%d", i), func(t *testing.T) {
+			result := cheslice.GroupConsecutive(c.input)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected []cheslice.Run[string]
+	}{
+		{
+			[]string{"a", "a", "a", "b", "a", "a"},
+			[]cheslice.Run[string]{{Value: "a", Count: 3}, {Value: "b", Count: 1}, {Value: "a", Count: 2}},
+		},
+		{[]string{"a"}, []cheslice.Run[string]{{Value: "a", Count: 1}}},
+		{[]string{}, []cheslice.Run[string]{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestRunLengthEncode_Case-%d", i), func(t *testing.T) {
+			result := cheslice.RunLengthEncode(c.input)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	cases := []struct {
+		input           []int
+		window          uint
+		expected        []float64
+		expectedPartial []float64
+	}{
+		{[]int{2, 4, 6, 8, 10}, 2, []float64{3, 5, 7, 9}, []float64{2, 3, 5, 7, 9}},
+		{[]int{1, 2, 3, 4}, 3, []float64{2, 3}, []float64{1, 1.5, 2, 3}},
+		{[]int{1, 2, 3, 4}, 1, []float64{1, 2, 3, 4}, []float64{1, 2, 3, 4}},
+		{[]int{1, 2}, 3, []float64{}, []float64{1, 1.5}},
+		{[]int{1, 2}, 0, []float64{}, []float64{}},
+		{[]int{}, 2, []float64{}, []float64{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestMovingAverage_Case-%d", i), func(t *testing.T) {
+			chetest.RequireEqual(t, cheslice.MovingAverage(c.input, c.window), c.expected)
+			chetest.RequireEqual(
+				t,
+				cheslice.MovingAverage(c.input, c.window, cheslice.WithPartialWindows()),
+				c.expectedPartial,
+			)
+		})
+	}
+}
+
+func TestMovingAverage_Floats(t *testing.T) {
+	cases := []struct {
+		input           []float64
+		window          uint
+		expected        []float64
+		expectedPartial []float64
+	}{
+		{[]float64{1e17, 1, 1, 1}, 1, []float64{1e17, 1, 1, 1}, []float64{1e17, 1, 1, 1}},
+		{[]float64{1e17, 1, 1, 1}, 2, []float64{5e16, 1, 1}, []float64{1e17, 5e16, 1, 1}},
+		{[]float64{math.Inf(1), 1, 2}, 1, []float64{math.Inf(1), 1, 2}, []float64{math.Inf(1), 1, 2}},
+		{[]float64{1, math.Inf(-1), 2, 4}, 2, []float64{math.Inf(-1), math.Inf(-1), 3}, []float64{1, math.Inf(-1), math.Inf(-1), 3}},
+		{
+			[]float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6},
+			2,
+			[]float64{0.15000000000000002, 0.25, 0.35, 0.45, 0.55},
+			[]float64{0.1, 0.15000000000000002, 0.25, 0.35, 0.45, 0.55},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestMovingAverage_Floats_Case-%d", i), func(t *testing.T) {
+			chetest.RequireEqual(t, cheslice.MovingAverage(c.input, c.window), c.expected)
+			chetest.RequireEqual(
+				t,
+				cheslice.MovingAverage(c.input, c.window, cheslice.WithPartialWindows()),
+				c.expectedPartial,
+			)
+		})
+	}
+}
+
+func TestMovingAverage_LargeInts(t *testing.T) {
+	input := []int64{1 << 60, 1, 1, 1}
+
+	chetest.RequireEqual(t, cheslice.MovingAverage(input, 1), []float64{1 << 60, 1, 1, 1})
+}
+
+func TestCumulativeSum(t *testing.T) {
+	cases := []struct {
+		input    []float64
+		expected []float64
+	}{
+		{[]float64{1, 2, 3, 4}, []float64{1, 3, 6, 10}},
+		{[]float64{1.5, -1.5, 2}, []float64{1.5, 0, 2}},
+		{[]float64{}, []float64{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("TestCumulativeSum_Case-%d", i), func(t *testing.T) {
+			result := cheslice.CumulativeSum(c.input)
+
+			chetest.RequireEqual(t, result, c.expected)
+		})
+	}
+}